@@ -0,0 +1,138 @@
+package keyring
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// EthSecp256k1 is the SigningAlgo for Ethereum-compatible keys: it derives
+// its address via keccak256 over the uncompressed public key rather than
+// the sha256/ripemd160 scheme used by the other algos.
+const EthSecp256k1 SigningAlgo = "eth-secp256k1"
+
+// EthCoinType is the BIP44 coin type registered for Ethereum (m/44'/60'/...);
+// BIP44CoinType(EthSecp256k1) returns it instead of the Cosmos coin type.
+// Nothing in this package derives keys or Ledger signatures with it.
+const EthCoinType = 60
+
+// AlgoInfo bundles everything the keyring needs to know about a SigningAlgo:
+// how to turn key-derivation bytes into a crypto.PrivKey, how to derive an
+// account address from the resulting pubkey, and which BIP44 coin type to
+// derive under. Third parties register their own SigningAlgo via
+// RegisterAlgo so downstream chains (e.g. Ethermint-style secp256k1-eth,
+// sr25519, BLS) can be added without forking the SDK.
+type AlgoInfo struct {
+	GenerateKey   func(bz []byte) crypto.PrivKey
+	DeriveAddress func(pub crypto.PubKey) types.AccAddress
+	BIP44CoinType func() uint32
+}
+
+// algoRegistry is a concurrency-safe map of SigningAlgo to its AlgoInfo.
+// It is deliberately a plain map guarded by a mutex rather than sync.Map:
+// registrations happen at init time and lookups vastly outnumber writes,
+// so a RWMutex keeps the common path cheap.
+type algoRegistry struct {
+	mu    sync.RWMutex
+	algos map[SigningAlgo]AlgoInfo
+}
+
+func newAlgoRegistry() *algoRegistry {
+	return &algoRegistry{algos: make(map[SigningAlgo]AlgoInfo)}
+}
+
+// AlgoRegistry is the default, package-wide SigningAlgo registry used by
+// the keyring. Third parties call RegisterAlgo to add their own algos to it.
+var AlgoRegistry = newAlgoRegistry()
+
+// RegisterAlgo registers algo's generator, address deriver, and BIP44 coin
+// type with AlgoRegistry. Re-registering an already-known SigningAlgo
+// overwrites the previous entry.
+func RegisterAlgo(algo SigningAlgo, info AlgoInfo) {
+	AlgoRegistry.mu.Lock()
+	defer AlgoRegistry.mu.Unlock()
+	AlgoRegistry.algos[algo] = info
+}
+
+// lookupAlgo returns the AlgoInfo registered for algo, if any.
+func lookupAlgo(algo SigningAlgo) (AlgoInfo, bool) {
+	AlgoRegistry.mu.RLock()
+	defer AlgoRegistry.mu.RUnlock()
+	info, ok := AlgoRegistry.algos[algo]
+	return info, ok
+}
+
+func init() {
+	ethsecp256k1.RegisterCodec(CryptoCdc)
+
+	RegisterAlgo(Secp256k1, AlgoInfo{
+		GenerateKey: func(bz []byte) crypto.PrivKey {
+			return secp256k1.GenPrivKeySecp256k1(bz)
+		},
+		DeriveAddress: func(pub crypto.PubKey) types.AccAddress {
+			return types.AccAddress(pub.Address())
+		},
+		BIP44CoinType: func() uint32 { return 118 },
+	})
+
+	RegisterAlgo(EthSecp256k1, AlgoInfo{
+		GenerateKey: func(bz []byte) crypto.PrivKey {
+			return ethsecp256k1.GenPrivKeyFromSecret(bz)
+		},
+		DeriveAddress: func(pub crypto.PubKey) types.AccAddress {
+			if ethPub, ok := pub.(ethsecp256k1.PubKeyEthSecp256k1); ok {
+				return types.AccAddress(ethPub.Address())
+			}
+			return types.AccAddress(pub.Address())
+		},
+		BIP44CoinType: func() uint32 { return EthCoinType },
+	})
+}
+
+// addressFromAlgo returns the address for pub under algo's registered
+// DeriveAddress func. Unregistered algos (including the zero value stored
+// in old Info records predating the Algo field) fall back to the default
+// crypto.PubKey.Address() behavior, preserving amino wire-format backwards
+// compatibility.
+func addressFromAlgo(algo SigningAlgo, pub crypto.PubKey) types.AccAddress {
+	if info, ok := lookupAlgo(algo); ok {
+		return info.DeriveAddress(pub)
+	}
+	return types.AccAddress(pub.Address())
+}
+
+// GenerateKey generates a new crypto.PrivKey for algo from derivation bytes
+// bz (e.g. an HD-derived seed), using the generator algo was registered
+// with, so third-party algos registered via RegisterAlgo produce keys the
+// same way the built-in ones do.
+//
+// NOTE: Keybase.CreateMnemonic/Derive are not part of this tree yet, so
+// they do not call this function today; once added, they should call
+// GenerateKey instead of hard-coding a key type.
+func GenerateKey(algo SigningAlgo, bz []byte) (crypto.PrivKey, error) {
+	info, ok := lookupAlgo(algo)
+	if !ok || info.GenerateKey == nil {
+		return nil, fmt.Errorf("no key generator registered for algo %q", algo)
+	}
+	return info.GenerateKey(bz), nil
+}
+
+// BIP44CoinType returns the BIP44 coin type algo was registered with, for
+// use when deriving HD keys (m/44'/<cointype>'/...), so the key's algo
+// decides the coin type instead of it always being the Cosmos one.
+//
+// NOTE: Keybase.Derive is not part of this tree yet, so it does not call
+// this function today; once added, it should call BIP44CoinType instead
+// of hard-coding the Cosmos coin type.
+func BIP44CoinType(algo SigningAlgo) (uint32, error) {
+	info, ok := lookupAlgo(algo)
+	if !ok || info.BIP44CoinType == nil {
+		return 0, fmt.Errorf("no BIP44 coin type registered for algo %q", algo)
+	}
+	return info.BIP44CoinType(), nil
+}
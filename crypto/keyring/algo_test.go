@@ -0,0 +1,41 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlgoRegistryGenerateKey(t *testing.T) {
+	priv, err := GenerateKey(Secp256k1, []byte("test seed"))
+	require.NoError(t, err)
+	require.NotNil(t, priv)
+
+	ethPriv, err := GenerateKey(EthSecp256k1, []byte("test seed"))
+	require.NoError(t, err)
+	require.NotNil(t, ethPriv)
+
+	_, err = GenerateKey(SigningAlgo("unregistered"), []byte("test seed"))
+	require.Error(t, err)
+}
+
+func TestAlgoRegistryBIP44CoinType(t *testing.T) {
+	coinType, err := BIP44CoinType(Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(118), coinType)
+
+	coinType, err = BIP44CoinType(EthSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(EthCoinType), coinType)
+
+	_, err = BIP44CoinType(SigningAlgo("unregistered"))
+	require.Error(t, err)
+}
+
+func TestAlgoRegistryDeriveAddress(t *testing.T) {
+	priv, err := GenerateKey(EthSecp256k1, []byte("test seed"))
+	require.NoError(t, err)
+
+	addr := addressFromAlgo(EthSecp256k1, priv.PubKey())
+	require.Len(t, addr, 20)
+}
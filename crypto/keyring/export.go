@@ -0,0 +1,155 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	"golang.org/x/crypto/openpgp/armor" //nolint:staticcheck
+)
+
+const (
+	blockTypeKeyInfo = "TENDERMINT KEY INFO"
+
+	headerVersion = "version"
+	headerKDF     = "kdf"
+	headerSalt    = "salt"
+	headerKeyType = "keytype"
+	headerType    = "type"
+	headerAddress = "address"
+
+	kdfBcrypt = "bcrypt"
+
+	// BcryptSecurityParameter is the work factor passed to bcrypt when
+	// deriving the symmetric key used to encrypt an exported Info record.
+	BcryptSecurityParameter = 12
+)
+
+// InfoImporter is the minimal storage contract ExportInfo/ImportInfo need
+// from whatever keybase backs the keyring: look an entry up by name, and
+// persist one under a (possibly different) name.
+//
+// NOTE: the concrete Keybase implementation is not part of this tree yet,
+// so nothing currently implements or calls InfoImporter outside of tests;
+// once Keybase is added, it should satisfy this interface so
+// `kb.ExportInfo`/`kb.ImportInfo`-style call sites can wrap these
+// functions.
+type InfoImporter interface {
+	// Get returns the Info stored under name.
+	Get(name string) (Info, error)
+	// Write persists info under name, overwriting any existing entry.
+	Write(name string, info Info) error
+}
+
+// ExportInfo looks up name in kb, amino-marshals the resulting Info (via
+// marshalInfo), and wraps the result in an ASCII-armored envelope
+// symmetrically encrypted with encryptPass, so a keyring entry - multisig
+// and ledger references included, not just raw private keys - can be
+// backed up or migrated across machines. The armor header carries the
+// key's type (headerKeyType), algo (headerType), and address in the
+// clear, so tools like `keys list` can preview an export without
+// decrypting its body.
+func ExportInfo(kb InfoImporter, name string, encryptPass string) (armorStr string, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	saltBytes, encBytes, err := encryptInfo(info, encryptPass)
+	if err != nil {
+		return "", err
+	}
+
+	header := map[string]string{
+		headerVersion: "0.0.1",
+		headerKDF:     kdfBcrypt,
+		headerSalt:    fmt.Sprintf("%X", saltBytes),
+		headerKeyType: info.GetType().String(),
+		headerType:    string(info.GetAlgo()),
+		headerAddress: info.GetAddress().String(),
+	}
+
+	buf := new(bytes.Buffer)
+	w, err := armor.Encode(buf, blockTypeKeyInfo, header)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(encBytes); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// ImportInfo reverses ExportInfo: it decodes armorStr, decrypts its body
+// with decryptPass, and writes the Info it contains into kb under name -
+// which need not match the name the entry was originally exported under -
+// completing the migration the armor was created for.
+func ImportInfo(kb InfoImporter, name string, armorStr string, decryptPass string) error {
+	buf := bytes.NewBufferString(armorStr)
+	block, err := armor.Decode(buf)
+	if err != nil {
+		return fmt.Errorf("couldn't decode armor: %v", err)
+	}
+	if block.Type != blockTypeKeyInfo {
+		return fmt.Errorf("unrecognized armor type %q, expected: %q", block.Type, blockTypeKeyInfo)
+	}
+	if block.Header[headerKDF] != kdfBcrypt {
+		return fmt.Errorf("unrecognized KDF type: %v", block.Header[headerKDF])
+	}
+
+	saltBytes, err := hex.DecodeString(block.Header[headerSalt])
+	if err != nil {
+		return fmt.Errorf("error decoding salt: %v", err)
+	}
+
+	encBytes, err := ioutil.ReadAll(block.Body)
+	if err != nil {
+		return err
+	}
+
+	bz, err := decryptInfo(saltBytes, encBytes, decryptPass)
+	if err != nil {
+		return err
+	}
+
+	info, err := unmarshalInfo(bz)
+	if err != nil {
+		return err
+	}
+
+	return kb.Write(name, info)
+}
+
+func encryptInfo(info Info, passphrase string) (saltBytes, encBytes []byte, err error) {
+	saltBytes = crypto.CRandBytes(16)
+
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating bcrypt key from passphrase: %v", err)
+	}
+	key = crypto.Sha256(key) // Get 32 bytes
+
+	return saltBytes, xsalsa20symmetric.EncryptSymmetric(marshalInfo(info), key), nil
+}
+
+func decryptInfo(saltBytes, encBytes []byte, passphrase string) ([]byte, error) {
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		return nil, fmt.Errorf("error generating bcrypt key from passphrase: %v", err)
+	}
+	key = crypto.Sha256(key) // Get 32 bytes
+
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting key info: wrong passphrase")
+	}
+	return bz, nil
+}
@@ -0,0 +1,66 @@
+package keyring
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"golang.org/x/crypto/openpgp/armor" //nolint:staticcheck
+)
+
+// memStore is a minimal in-memory InfoImporter used only to exercise
+// ExportInfo/ImportInfo; it is not a stand-in for the real Keybase store.
+type memStore map[string]Info
+
+func (m memStore) Get(name string) (Info, error) {
+	info, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("no key for name: %s", name)
+	}
+	return info, nil
+}
+
+func (m memStore) Write(name string, info Info) error {
+	m[name] = info
+	return nil
+}
+
+func TestExportImportInfoRoundTrip(t *testing.T) {
+	pub := ed25519.GenPrivKey().PubKey()
+	info := newOfflineInfo("foo", pub, Secp256k1)
+	store := memStore{"foo": info}
+
+	armorStr, err := ExportInfo(store, "foo", "password")
+	require.NoError(t, err)
+	require.NotEmpty(t, armorStr)
+
+	block, err := armor.Decode(strings.NewReader(armorStr))
+	require.NoError(t, err)
+	require.Equal(t, string(Secp256k1), block.Header[headerType])
+	require.Equal(t, info.GetType().String(), block.Header[headerKeyType])
+	require.Equal(t, info.GetAddress().String(), block.Header[headerAddress])
+
+	dest := memStore{}
+	err = ImportInfo(dest, "bar", armorStr, "password")
+	require.NoError(t, err)
+
+	imported, err := dest.Get("bar")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), imported.GetAddress())
+	require.Equal(t, info.GetAlgo(), imported.GetAlgo())
+}
+
+func TestImportInfoWrongPassphrase(t *testing.T) {
+	pub := ed25519.GenPrivKey().PubKey()
+	info := newOfflineInfo("foo", pub, Secp256k1)
+	store := memStore{"foo": info}
+
+	armorStr, err := ExportInfo(store, "foo", "correct horse")
+	require.NoError(t, err)
+
+	dest := memStore{}
+	err = ImportInfo(dest, "foo", armorStr, "wrong passphrase")
+	require.Error(t, err)
+}
@@ -26,11 +26,25 @@ type Info interface {
 	GetAlgo() SigningAlgo
 }
 
+// MultiInfo is an Info sub-interface implemented by multisig keys, exposing
+// the threshold and member pubkeys so CLI/REST callers can render the full
+// (possibly nested) multisig tree.
+type MultiInfo interface {
+	Info
+	// GetThreshold returns the number of signatures required by this
+	// multisig key
+	GetThreshold() uint
+	// GetPubKeys returns the member pubkeys of this multisig key, along
+	// with their effective weight
+	GetPubKeys() []multisigPubKeyInfo
+}
+
 var (
-	_ Info = &localInfo{}
-	_ Info = &ledgerInfo{}
-	_ Info = &offlineInfo{}
-	_ Info = &multiInfo{}
+	_ Info      = &localInfo{}
+	_ Info      = &ledgerInfo{}
+	_ Info      = &offlineInfo{}
+	_ Info      = &multiInfo{}
+	_ MultiInfo = &multiInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -68,7 +82,7 @@ func (i localInfo) GetPubKey() crypto.PubKey {
 
 // GetType implements Info interface
 func (i localInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return addressFromAlgo(i.Algo, i.PubKey)
 }
 
 // GetType implements Info interface
@@ -116,7 +130,7 @@ func (i ledgerInfo) GetPubKey() crypto.PubKey {
 
 // GetAddress implements Info interface
 func (i ledgerInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return addressFromAlgo(i.Algo, i.PubKey)
 }
 
 // GetPath implements Info interface
@@ -168,7 +182,7 @@ func (i offlineInfo) GetAlgo() SigningAlgo {
 
 // GetAddress implements Info interface
 func (i offlineInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return addressFromAlgo(i.Algo, i.PubKey)
 }
 
 // GetPath implements Info interface
@@ -195,8 +209,7 @@ func NewMultiInfo(name string, pub crypto.PubKey) Info {
 
 	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
 	for i, pk := range multiPK.PubKeys {
-		// TODO: Recursively check pk for total weight?
-		pubKeys[i] = multisigPubKeyInfo{pk, 1}
+		pubKeys[i] = multisigPubKeyInfo{pk, multisigWeight(pk)}
 	}
 
 	return &multiInfo{
@@ -207,6 +220,25 @@ func NewMultiInfo(name string, pub crypto.PubKey) Info {
 	}
 }
 
+// multisigWeight computes the effective weight a sub-pubkey contributes to
+// its parent multisig. A plain (non-multisig) pubkey always has weight 1.
+// A nested multisig pubkey's weight is the sum of the effective weights of
+// its own children, computed recursively, so that deeply nested multisig
+// trees report accurate per-branch weights instead of treating every
+// branch as a single signer.
+func multisigWeight(pk crypto.PubKey) uint {
+	nested, ok := pk.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return 1
+	}
+
+	var weight uint
+	for _, child := range nested.PubKeys {
+		weight += multisigWeight(child)
+	}
+	return weight
+}
+
 // GetType implements Info interface
 func (i multiInfo) GetType() KeyType {
 	return TypeMulti
@@ -237,6 +269,16 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// GetThreshold implements MultiInfo interface
+func (i multiInfo) GetThreshold() uint {
+	return i.Threshold
+}
+
+// GetPubKeys implements MultiInfo interface
+func (i multiInfo) GetPubKeys() []multisigPubKeyInfo {
+	return i.PubKeys
+}
+
 // encoding info
 func marshalInfo(i Info) []byte {
 	return CryptoCdc.MustMarshalBinaryLengthPrefixed(i)
@@ -0,0 +1,37 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+func TestNewMultiInfoRecursiveWeight(t *testing.T) {
+	// innermost: a plain 2-of-2 multisig, so it contributes weight 2 to its parent
+	innerLeaf1 := ed25519.GenPrivKey().PubKey()
+	innerLeaf2 := ed25519.GenPrivKey().PubKey()
+	inner := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{innerLeaf1, innerLeaf2})
+
+	// middle: wraps inner (weight 2) alongside a plain key (weight 1) in a 2-of-2,
+	// so middle itself contributes weight 3 to its parent
+	middleLeaf := ed25519.GenPrivKey().PubKey()
+	middle := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{inner, middleLeaf})
+
+	// top: wraps middle (weight 3) alongside a plain key (weight 1) in a 1-of-2
+	topLeaf := ed25519.GenPrivKey().PubKey()
+	top := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{middle, topLeaf})
+
+	info := NewMultiInfo("three-level", top)
+
+	multi, ok := info.(MultiInfo)
+	require.True(t, ok)
+	require.Equal(t, uint(1), multi.GetThreshold())
+
+	pubKeys := multi.GetPubKeys()
+	require.Len(t, pubKeys, 2)
+	require.Equal(t, uint(3), pubKeys[0].Weight, "nested middle multisig should report the sum of its children's weights")
+	require.Equal(t, uint(1), pubKeys[1].Weight, "plain leaf keys always have weight 1")
+}
@@ -0,0 +1,188 @@
+package ethsecp256k1
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/sha3"
+)
+
+//-------------------------------------
+
+const (
+	PrivKeyAminoName = "tendermint/PrivKeyEthSecp256k1"
+	PubKeyAminoName  = "tendermint/PubKeyEthSecp256k1"
+
+	// PubKeyEthSecp256k1Size is the size, in bytes, of uncompressed public keys
+	// as used to derive Ethereum-style addresses.
+	PubKeyEthSecp256k1Size = 65
+	// PrivKeySecp256k1Size is the size, in bytes, of private keys as used
+	// by this implementation.
+	PrivKeySecp256k1Size = 32
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeyEthSecp256k1{}, PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeyEthSecp256k1{}, PrivKeyAminoName, nil)
+}
+
+// RegisterCodec registers the Ethereum secp256k1 key types on the given
+// amino codec so they can be marshalled/unmarshalled alongside the other
+// Info implementations stored in the keyring.
+func RegisterCodec(c *amino.Codec) {
+	c.RegisterConcrete(PubKeyEthSecp256k1{}, PubKeyAminoName, nil)
+	c.RegisterConcrete(PrivKeyEthSecp256k1{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+// PrivKeyEthSecp256k1 is a wrapper around a secp256k1 private key that
+// derives its public key and address the same way go-ethereum does, so the
+// resulting keys are usable on Ethereum-compatible chains.
+type PrivKeyEthSecp256k1 [PrivKeySecp256k1Size]byte
+
+// Bytes returns the amino encoding of the private key.
+func (privKey PrivKeyEthSecp256k1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign signs the keccak256 hash of msg and returns a 65-byte recoverable
+// signature R(32) || S(32) || V(1), matching Ethereum's signing
+// convention (the same format go-ethereum's crypto.Sign/crypto.Ecrecover
+// use) so the signature is usable outside this package with external
+// Ethereum tx/signature tooling.
+func (privKey PrivKeyEthSecp256k1) Sign(msg []byte) ([]byte, error) {
+	priv, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey[:])
+	compactSig, err := secp256k1.SignCompact(secp256k1.S256(), priv, Keccak256(msg), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// compactSig is [recovery header(1) | R(32) | S(32)], header = 27 + recID
+	// (+ 4 if for a compressed pubkey, which we never pass here). Ethereum's
+	// convention instead puts the plain recovery ID last: R || S || V.
+	sig := make([]byte, 65)
+	copy(sig[0:32], compactSig[1:33])
+	copy(sig[32:64], compactSig[33:65])
+	sig[64] = compactSig[0] - 27
+	return sig, nil
+}
+
+// PubKey returns the uncompressed secp256k1 public key associated with
+// this private key.
+func (privKey PrivKeyEthSecp256k1) PubKey() crypto.PubKey {
+	_, pubkeyObject := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey[:])
+
+	var pubkeyBytes PubKeyEthSecp256k1
+	copy(pubkeyBytes[:], pubkeyObject.SerializeUncompressed())
+	return pubkeyBytes
+}
+
+// Equals implements crypto.PrivKey.
+func (privKey PrivKeyEthSecp256k1) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKeyEthSecp256k1); ok {
+		return bytes.Equal(privKey[:], otherSecp[:])
+	}
+	return false
+}
+
+// GenPrivKey generates a new secp256k1 private key usable for Ethereum
+// addresses. It maps the random seed into a valid scalar using the
+// secp256k1 group order (see GenPrivKeyFromSecret).
+func GenPrivKey() PrivKeyEthSecp256k1 {
+	return GenPrivKeyFromSecret(crypto.CRandBytes(PrivKeySecp256k1Size))
+}
+
+// GenPrivKeyFromSecret derives a private key deterministically from the
+// given secret. It hashes the secret with SHA256, then maps the hash into
+// the range [1, n-1] (n = secp256k1's group order) via
+// c = sha256(secret); k = (c mod (n-1)) + 1
+// which guarantees a valid, non-zero scalar without needing a
+// rejection-sampling loop.
+func GenPrivKeyFromSecret(secret []byte) PrivKeyEthSecp256k1 {
+	secHash := crypto.Sha256(secret)
+
+	fe := new(big.Int).SetBytes(secHash)
+	n := new(big.Int).Sub(secp256k1.S256().N, big.NewInt(1))
+	fe.Mod(fe, n)
+	fe.Add(fe, big.NewInt(1))
+
+	feBytes := fe.Bytes()
+	var privKey PrivKeyEthSecp256k1
+	copy(privKey[PrivKeySecp256k1Size-len(feBytes):], feBytes)
+	return privKey
+}
+
+//-------------------------------------
+
+// PubKeyEthSecp256k1 is an uncompressed secp256k1 public key whose Address
+// is derived the Ethereum way: the last 20 bytes of the keccak256 hash of
+// the 64-byte (X || Y) public key, skipping the 0x04 prefix byte.
+type PubKeyEthSecp256k1 [PubKeyEthSecp256k1Size]byte
+
+// Address returns the Ethereum-style address for this public key: the last
+// 20 bytes of keccak256(X || Y).
+func (pubKey PubKeyEthSecp256k1) Address() crypto.Address {
+	hash := Keccak256(pubKey[1:])
+	return crypto.Address(hash[12:])
+}
+
+// Bytes returns the amino encoding of the public key.
+func (pubKey PubKeyEthSecp256k1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a 65-byte recoverable R || S || V signature (as
+// produced by Sign) by recovering the signer's pubkey from it and
+// comparing against pubKey, the same way Ethereum's ecrecover-based
+// verification works.
+func (pubKey PubKeyEthSecp256k1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != 65 {
+		return false
+	}
+
+	// rebuild the [recovery header(1) | R(32) | S(32)] layout RecoverCompact
+	// expects from Ethereum's R || S || V layout.
+	compactSig := make([]byte, 65)
+	compactSig[0] = sig[64] + 27
+	copy(compactSig[1:33], sig[0:32])
+	copy(compactSig[33:65], sig[32:64])
+
+	recoveredPub, _, err := secp256k1.RecoverCompact(secp256k1.S256(), compactSig, Keccak256(msg))
+	if err != nil {
+		return false
+	}
+
+	var recovered PubKeyEthSecp256k1
+	copy(recovered[:], recoveredPub.SerializeUncompressed())
+	return bytes.Equal(recovered[:], pubKey[:])
+}
+
+// Equals implements crypto.PubKey.
+func (pubKey PubKeyEthSecp256k1) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKeyEthSecp256k1); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
+
+func (pubKey PubKeyEthSecp256k1) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey[:])
+}
+
+// Keccak256 returns the Keccak256 hash of the input, as used throughout the
+// Ethereum ecosystem for address and transaction hashing.
+func Keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,31 @@
+package ethsecp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey().(PubKeyEthSecp256k1)
+
+	msg := []byte("test message")
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+
+	require.True(t, pub.VerifyBytes(msg, sig))
+	require.False(t, pub.VerifyBytes([]byte("other message"), sig))
+
+	otherPriv := GenPrivKey()
+	require.False(t, otherPriv.PubKey().(PubKeyEthSecp256k1).VerifyBytes(msg, sig))
+}
+
+func TestAddressFromUncompressedPubKey(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey().(PubKeyEthSecp256k1)
+
+	addr := pub.Address()
+	require.Len(t, addr, 20)
+}